@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// aliasMu guards aliasDefinitions and aliasMessages, which are mutated via
+// RegisterAlias/RegisterAliasMessage at any point in the process lifetime
+// (not just during the sync.Once init), so they need their own lock
+// independent of the validator singleton's init-once.
+var (
+	aliasMu          sync.RWMutex
+	aliasDefinitions = map[string]string{}
+	aliasMessages    = map[string]string{}
+)
+
+// RegisterAlias defines a reusable rule bundle: applications can write
+// `validate:"village_ref"` instead of repeating `validate:"required,village_id"`
+// on every struct. The alias is registered on the validator singleton as a
+// first-class tag, so FieldError.Tag() for a failure reports the alias name
+// itself rather than whichever sub-tag actually failed. Aliases may reference
+// other aliases (alias-of-alias); resolution happens naturally because each
+// alias is itself a registered tag.
+func RegisterAlias(alias, tags string) {
+	aliasMu.Lock()
+	aliasDefinitions[alias] = tags
+	aliasMu.Unlock()
+
+	v := getValidator()
+	if err := v.RegisterValidation(alias, aliasValidatorFunc(alias)); err != nil && !strings.Contains(err.Error(), "already exists") {
+		fmt.Printf("Failed to register alias %s: %v\n", alias, err)
+	}
+}
+
+// RegisterAliasMessage sets the friendly error message formatValidationMessage
+// prints when alias fails, instead of the message for whichever underlying
+// sub-tag actually failed.
+func RegisterAliasMessage(alias, template string) {
+	aliasMu.Lock()
+	aliasMessages[alias] = template
+	aliasMu.Unlock()
+}
+
+// aliasMessage returns the registered message for tag, if any.
+func aliasMessage(tag string) (string, bool) {
+	aliasMu.RLock()
+	defer aliasMu.RUnlock()
+	msg, ok := aliasMessages[tag]
+	return msg, ok
+}
+
+// aliasValidatorFunc builds a validator.Func that re-runs alias's current
+// definition against the field's value via ValidateVar, so an alias's
+// pass/fail collapses to a single tag result under the alias's own name.
+// go-playground/validator caches the *validator.Func pointer returned here
+// per reflect.Type, so the func must look up aliasDefinitions[alias] at
+// validation time rather than closing over a tags snapshot - otherwise a
+// later RegisterAlias(alias, ...) redefinition would never take effect for
+// any struct type already validated.
+func aliasValidatorFunc(alias string) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		aliasMu.RLock()
+		tags := aliasDefinitions[alias]
+		aliasMu.RUnlock()
+		return len(ValidateVar(fl.Field().Interface(), tags)) == 0
+	}
+}
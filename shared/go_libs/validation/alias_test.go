@@ -0,0 +1,84 @@
+package validation
+
+import "testing"
+
+// TestRegisterAlias verifies a composite rule bundle can be referenced by a
+// single tag.
+func TestRegisterAlias(t *testing.T) {
+	RegisterAlias("alias_village_ref", "required,village_id")
+
+	type Village struct {
+		ID string `validate:"alias_village_ref"`
+	}
+
+	if errs := ValidateStruct(Village{ID: ""}); len(errs) != 1 {
+		t.Errorf("expected 1 error for empty ID, got %d: %v", len(errs), errs)
+	}
+	if errs := ValidateStruct(Village{ID: "bad"}); len(errs) != 1 {
+		t.Errorf("expected 1 error for malformed ID, got %d: %v", len(errs), errs)
+	}
+	if errs := ValidateStruct(Village{ID: "a1b2-c3d4-e5f67890"}); len(errs) != 0 {
+		t.Errorf("expected no errors for valid ID, got %d: %v", len(errs), errs)
+	}
+}
+
+// TestRegisterAliasOverride verifies re-registering an alias redefines it.
+func TestRegisterAliasOverride(t *testing.T) {
+	RegisterAlias("alias_override_test", "required")
+
+	type Data struct {
+		Value string `validate:"alias_override_test"`
+	}
+
+	if errs := ValidateStruct(Data{Value: "anything"}); len(errs) != 0 {
+		t.Errorf("expected no errors under 'required' definition, got %v", errs)
+	}
+
+	RegisterAlias("alias_override_test", "required,email")
+
+	if errs := ValidateStruct(Data{Value: "anything"}); len(errs) != 1 {
+		t.Errorf("expected 1 error after redefinition to 'required,email', got %d: %v", len(errs), errs)
+	}
+	if errs := ValidateStruct(Data{Value: "a@b.com"}); len(errs) != 0 {
+		t.Errorf("expected no errors for valid email after redefinition, got %v", errs)
+	}
+}
+
+// TestRegisterAliasOfAlias verifies an alias may reference another alias.
+func TestRegisterAliasOfAlias(t *testing.T) {
+	RegisterAlias("alias_base_policy", "required,strong_password")
+	RegisterAlias("alias_admin_password", "alias_base_policy,min=12")
+
+	type Admin struct {
+		Password string `validate:"alias_admin_password"`
+	}
+
+	if errs := ValidateStruct(Admin{Password: ""}); len(errs) == 0 {
+		t.Errorf("expected errors for empty admin password")
+	}
+	if errs := ValidateStruct(Admin{Password: "Sh0rt!"}); len(errs) == 0 {
+		t.Errorf("expected errors for a too-short admin password")
+	}
+	if errs := ValidateStruct(Admin{Password: "LongEnoughP@ss1"}); len(errs) != 0 {
+		t.Errorf("expected no errors for a valid admin password, got %v", errs)
+	}
+}
+
+// TestRegisterAliasMessage verifies the alias's friendly message wins over
+// the underlying sub-tag's message.
+func TestRegisterAliasMessage(t *testing.T) {
+	RegisterAlias("alias_messaged", "required,village_id")
+	RegisterAliasMessage("alias_messaged", "must be a valid village reference")
+
+	type Village struct {
+		ID string `validate:"alias_messaged"`
+	}
+
+	errs := ValidateStruct(Village{ID: "bad"})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Message != "must be a valid village reference" {
+		t.Errorf("expected alias message, got %q", errs[0].Message)
+	}
+}
@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRegisterTranslation verifies a custom locale/tag template is picked up
+// by ValidateStructLocalized, including a locale not shipped in locales/.
+func TestRegisterTranslation(t *testing.T) {
+	RegisterTranslation("it", "required", "il campo è obbligatorio")
+	t.Cleanup(func() {
+		// "it" isn't a shipped locale, so remove the whole bundle rather
+		// than restoring a prior value; locales is process-global and
+		// TestValidateStructLocalized relies on "it" being unrecognized.
+		localesMu.Lock()
+		delete(locales, "it")
+		localesMu.Unlock()
+	})
+
+	type Data struct {
+		Name string `validate:"required"`
+	}
+
+	errs := ValidateStructLocalized(Data{}, "it")
+	if len(errs) != 1 || errs[0].Message != "il campo è obbligatorio" {
+		t.Errorf("expected Italian translation, got %v", errs)
+	}
+}
+
+// TestRegisterTranslationOverride verifies RegisterTranslation can override
+// a shipped locale's template.
+func TestRegisterTranslationOverride(t *testing.T) {
+	RegisterTranslation("en", "required", "you must provide this field")
+
+	type Data struct {
+		Name string `validate:"required"`
+	}
+
+	errs := ValidateStructLocalized(Data{}, "en")
+	if len(errs) != 1 || errs[0].Message != "you must provide this field" {
+		t.Errorf("expected overridden English translation, got %v", errs)
+	}
+
+	// Restore, since locales is process-global and other tests rely on the
+	// shipped "field is required" message.
+	RegisterTranslation("en", "required", "field is required")
+}
+
+// TestValidateStructCtx verifies locale resolution via context.
+func TestValidateStructCtx(t *testing.T) {
+	type Data struct {
+		Name string `validate:"required"`
+	}
+
+	ctx := WithLocale(context.Background(), "es")
+	errs := ValidateStructCtx(ctx, Data{})
+	if len(errs) != 1 || errs[0].Message != "el campo es obligatorio" {
+		t.Errorf("expected Spanish translation via context, got %v", errs)
+	}
+}
+
+// TestValidateStructCtxDefaultsToEnglish verifies a context with no locale
+// set falls back to English.
+func TestValidateStructCtxDefaultsToEnglish(t *testing.T) {
+	type Data struct {
+		Name string `validate:"required"`
+	}
+
+	errs := ValidateStructCtx(context.Background(), Data{})
+	if len(errs) != 1 || errs[0].Message != "field is required" {
+		t.Errorf("expected default English translation, got %v", errs)
+	}
+}
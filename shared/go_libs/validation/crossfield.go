@@ -0,0 +1,200 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// crossFieldValidators are cross-field/cross-struct comparison tags.
+// go-playground/validator ships same-level eqfield/nefield/gtfield/ltfield
+// and cross-struct eqcsfield/necsfield/etc., but neither resolves a dotted
+// path (e.g. "Address.Country") from the top-level struct, which is what
+// nested structs in this codebase need. These override the plain tag names
+// with a resolver that walks fl.Top() by the dotted path in Param(),
+// falling back to fl.Parent() for a bare field name so existing single-level
+// usage (ConfirmPassword validate:"eqfield=Password") keeps working. The
+// required_if/required_unless/required_with tags resolve every name,
+// bare or dotted, from fl.Top().
+var crossFieldValidators = map[string]validator.Func{
+	"eqfield":         crossFieldCompare(compareEqual),
+	"nefield":         crossFieldCompare(compareNotEqual),
+	"gtfield":         crossFieldCompare(compareGreater),
+	"ltfield":         crossFieldCompare(compareLess),
+	"required_if":     validateRequiredIf,
+	"required_unless": validateRequiredUnless,
+	"required_with":   validateRequiredWith,
+}
+
+func registerCrossFieldValidators(v *validator.Validate) {
+	for tag, fn := range crossFieldValidators {
+		if err := v.RegisterValidation(tag, fn); err != nil && !strings.Contains(err.Error(), "already exists") {
+			fmt.Printf("Failed to register %s validator: %v\n", tag, err)
+		}
+	}
+}
+
+// resolveFieldByPath walks root field-by-field along a dotted path
+// ("Address.Country"), dereferencing pointers along the way.
+func resolveFieldByPath(root reflect.Value, path string) (reflect.Value, bool) {
+	v := root
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+
+	for _, part := range strings.Split(path, ".") {
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		v = v.FieldByName(part)
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return v, true
+			}
+			v = v.Elem()
+		}
+	}
+	return v, true
+}
+
+// referencedField resolves fl.Param() as a dotted path from the top-level
+// struct, falling back to a bare field name resolved against the immediate
+// parent struct (matching plain eqfield/nefield semantics).
+func referencedField(fl validator.FieldLevel) (reflect.Value, bool) {
+	path := fl.Param()
+	if strings.Contains(path, ".") {
+		return resolveFieldByPath(fl.Top(), path)
+	}
+	return resolveFieldByPath(fl.Parent(), path)
+}
+
+type compareFunc func(field, other reflect.Value) bool
+
+func crossFieldCompare(cmp compareFunc) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		other, ok := referencedField(fl)
+		if !ok {
+			return false
+		}
+		return cmp(fl.Field(), other)
+	}
+}
+
+func compareEqual(field, other reflect.Value) bool {
+	return fmt.Sprint(field.Interface()) == fmt.Sprint(other.Interface())
+}
+
+func compareNotEqual(field, other reflect.Value) bool {
+	return !compareEqual(field, other)
+}
+
+func compareGreater(field, other reflect.Value) bool {
+	a, aOK := numericValue(field)
+	b, bOK := numericValue(other)
+	if aOK && bOK {
+		return a > b
+	}
+	return fmt.Sprint(field.Interface()) > fmt.Sprint(other.Interface())
+}
+
+func compareLess(field, other reflect.Value) bool {
+	a, aOK := numericValue(field)
+	b, bOK := numericValue(other)
+	if aOK && bOK {
+		return a < b
+	}
+	return fmt.Sprint(field.Interface()) < fmt.Sprint(other.Interface())
+}
+
+// numericValue extracts a float64 from v if it's a numeric kind, string
+// containing a number, or time.Time (as Unix seconds).
+func numericValue(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return float64(t.Unix()), true
+		}
+	}
+	return 0, false
+}
+
+// parseFieldValuePairs parses a `required_if`/`required_unless` param string
+// of space-separated "Field Value" pairs (e.g. "Kind admin Status active").
+func parseFieldValuePairs(param string) [][2]string {
+	tokens := strings.Fields(param)
+	var pairs [][2]string
+	for i := 0; i+1 < len(tokens); i += 2 {
+		pairs = append(pairs, [2]string{tokens[i], tokens[i+1]})
+	}
+	return pairs
+}
+
+// allPairsMatch reports whether every "Field Value" pair in pairs matches the
+// corresponding field's current string value on root, resolving each Field as
+// a dotted path.
+func allPairsMatch(root reflect.Value, pairs [][2]string) bool {
+	for _, pair := range pairs {
+		fv, ok := resolveFieldByPath(root, pair[0])
+		if !ok {
+			return false
+		}
+		if fmt.Sprint(fv.Interface()) != pair[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// validateRequiredIf makes the field required only when the referenced
+// field(s) hold the given value(s), e.g. `validate:"required_if=Kind admin"`.
+func validateRequiredIf(fl validator.FieldLevel) bool {
+	if allPairsMatch(fl.Top(), parseFieldValuePairs(fl.Param())) {
+		return hasValue(fl.Field())
+	}
+	return true
+}
+
+// validateRequiredUnless makes the field required unless the referenced
+// field(s) hold the given value(s).
+func validateRequiredUnless(fl validator.FieldLevel) bool {
+	if !allPairsMatch(fl.Top(), parseFieldValuePairs(fl.Param())) {
+		return hasValue(fl.Field())
+	}
+	return true
+}
+
+// validateRequiredWith makes the field required if any of the named fields
+// (space-separated in Param, each resolved from the top-level struct, dotted
+// paths allowed) is non-zero.
+func validateRequiredWith(fl validator.FieldLevel) bool {
+	for _, name := range strings.Fields(fl.Param()) {
+		other, ok := resolveFieldByPath(fl.Top(), name)
+		if ok && hasValue(other) {
+			return hasValue(fl.Field())
+		}
+	}
+	return true
+}
+
+func hasValue(v reflect.Value) bool {
+	return !v.IsZero()
+}
@@ -0,0 +1,136 @@
+package validation
+
+import "testing"
+
+// TestEqField verifies eqfield on sibling fields.
+func TestEqField(t *testing.T) {
+	type Signup struct {
+		Password        string
+		ConfirmPassword string `validate:"eqfield=Password"`
+	}
+
+	if errs := ValidateStruct(Signup{Password: "secret", ConfirmPassword: "secret"}); len(errs) != 0 {
+		t.Errorf("expected matching passwords to pass, got %v", errs)
+	}
+	if errs := ValidateStruct(Signup{Password: "secret", ConfirmPassword: "other"}); len(errs) != 1 {
+		t.Errorf("expected mismatched passwords to fail, got %v", errs)
+	}
+}
+
+// TestNeField verifies nefield on sibling fields.
+func TestNeField(t *testing.T) {
+	type Account struct {
+		Username string
+		Password string `validate:"nefield=Username"`
+	}
+
+	if errs := ValidateStruct(Account{Username: "jdoe", Password: "jdoe"}); len(errs) != 1 {
+		t.Errorf("expected password equal to username to fail, got %v", errs)
+	}
+	if errs := ValidateStruct(Account{Username: "jdoe", Password: "s3cret"}); len(errs) != 0 {
+		t.Errorf("expected distinct password to pass, got %v", errs)
+	}
+}
+
+// TestGtFieldLtField verifies gtfield/ltfield numeric comparisons.
+func TestGtFieldLtField(t *testing.T) {
+	type Range struct {
+		Min int
+		Max int `validate:"gtfield=Min"`
+	}
+
+	if errs := ValidateStruct(Range{Min: 5, Max: 10}); len(errs) != 0 {
+		t.Errorf("expected Max > Min to pass, got %v", errs)
+	}
+	if errs := ValidateStruct(Range{Min: 10, Max: 5}); len(errs) != 1 {
+		t.Errorf("expected Max < Min to fail gtfield, got %v", errs)
+	}
+
+	type Reverse struct {
+		Max int
+		Min int `validate:"ltfield=Max"`
+	}
+	if errs := ValidateStruct(Reverse{Max: 10, Min: 5}); len(errs) != 0 {
+		t.Errorf("expected Min < Max to pass, got %v", errs)
+	}
+}
+
+// TestEqFieldDottedPath verifies eqfield resolves a dotted path against the
+// top-level struct for nested fields.
+func TestEqFieldDottedPath(t *testing.T) {
+	type Address struct {
+		Country string
+	}
+	type Shipment struct {
+		Address        Address
+		BillingCountry string `validate:"eqfield=Address.Country"`
+	}
+
+	if errs := ValidateStruct(Shipment{
+		Address:        Address{Country: "US"},
+		BillingCountry: "US",
+	}); len(errs) != 0 {
+		t.Errorf("expected matching nested countries to pass, got %v", errs)
+	}
+
+	if errs := ValidateStruct(Shipment{
+		Address:        Address{Country: "US"},
+		BillingCountry: "CA",
+	}); len(errs) != 1 {
+		t.Errorf("expected mismatched nested countries to fail, got %v", errs)
+	}
+}
+
+// TestRequiredIf verifies required_if only requires the field when the
+// referenced field matches the given value.
+func TestRequiredIf(t *testing.T) {
+	type User struct {
+		Kind       string
+		AdminLevel string `validate:"required_if=Kind admin"`
+	}
+
+	if errs := ValidateStruct(User{Kind: "guest"}); len(errs) != 0 {
+		t.Errorf("expected no error when Kind != admin, got %v", errs)
+	}
+	if errs := ValidateStruct(User{Kind: "admin"}); len(errs) != 1 {
+		t.Errorf("expected AdminLevel required when Kind == admin, got %v", errs)
+	}
+	if errs := ValidateStruct(User{Kind: "admin", AdminLevel: "super"}); len(errs) != 0 {
+		t.Errorf("expected no error when AdminLevel is set, got %v", errs)
+	}
+}
+
+// TestRequiredUnless verifies required_unless requires the field unless the
+// referenced field matches the given value.
+func TestRequiredUnless(t *testing.T) {
+	type User struct {
+		Kind  string
+		Email string `validate:"required_unless=Kind guest"`
+	}
+
+	if errs := ValidateStruct(User{Kind: "guest"}); len(errs) != 0 {
+		t.Errorf("expected no error when Kind == guest, got %v", errs)
+	}
+	if errs := ValidateStruct(User{Kind: "member"}); len(errs) != 1 {
+		t.Errorf("expected Email required when Kind != guest, got %v", errs)
+	}
+}
+
+// TestRequiredWith verifies required_with requires the field when a named
+// sibling field is present.
+func TestRequiredWith(t *testing.T) {
+	type Contact struct {
+		Phone       string
+		PhoneRegion string `validate:"required_with=Phone"`
+	}
+
+	if errs := ValidateStruct(Contact{}); len(errs) != 0 {
+		t.Errorf("expected no error when Phone is empty, got %v", errs)
+	}
+	if errs := ValidateStruct(Contact{Phone: "555-1234"}); len(errs) != 1 {
+		t.Errorf("expected PhoneRegion required when Phone is set, got %v", errs)
+	}
+	if errs := ValidateStruct(Contact{Phone: "555-1234", PhoneRegion: "US"}); len(errs) != 0 {
+		t.Errorf("expected no error when both fields are set, got %v", errs)
+	}
+}
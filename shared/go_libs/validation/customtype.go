@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"database/sql"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterCustomTypeFunc registers fn to extract the underlying value from
+// any of the given types before validation tags run against it. This is a
+// thin wrapper around go-playground/validator's own RegisterCustomTypeFunc,
+// exposed here so callers can plug in support for driver.Valuer-style types
+// (sql.NullString, uuid.UUID, ...) or their own wrapper types without
+// reaching into a *validator.Validate directly.
+//
+// Example usage:
+//
+//	type Cents int64
+//	validation.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+//	    return int64(field.Interface().(Cents))
+//	}, Cents(0))
+func RegisterCustomTypeFunc(fn func(reflect.Value) interface{}, types ...interface{}) {
+	getValidator().RegisterCustomTypeFunc(validator.CustomTypeFunc(fn), types...)
+}
+
+// registerSQLNullTypes teaches the validator to look through database/sql's
+// Null* wrapper types to the value they hold. Returning nil for Valid=false
+// tells go-playground/validator to treat the field as its zero value, so
+// `required` and friends behave as if the field were absent.
+func registerSQLNullTypes(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(sqlNullValue, sql.NullString{}, sql.NullInt64{}, sql.NullBool{}, sql.NullTime{})
+}
+
+func sqlNullValue(field reflect.Value) interface{} {
+	switch value := field.Interface().(type) {
+	case sql.NullString:
+		if !value.Valid {
+			return nil
+		}
+		return value.String
+	case sql.NullInt64:
+		if !value.Valid {
+			return nil
+		}
+		return value.Int64
+	case sql.NullBool:
+		if !value.Valid {
+			return nil
+		}
+		return value.Bool
+	case sql.NullTime:
+		if !value.Valid {
+			return nil
+		}
+		return value.Time
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestSQLNullStringRequired verifies required treats an invalid NullString
+// as absent and a valid one as present.
+func TestSQLNullStringRequired(t *testing.T) {
+	type Form struct {
+		Nickname sql.NullString `validate:"required"`
+	}
+
+	if errs := ValidateStruct(Form{Nickname: sql.NullString{Valid: false}}); len(errs) != 1 {
+		t.Errorf("expected required to fail for an invalid NullString, got %v", errs)
+	}
+	if errs := ValidateStruct(Form{Nickname: sql.NullString{String: "jdoe", Valid: true}}); len(errs) != 0 {
+		t.Errorf("expected no error for a valid NullString, got %v", errs)
+	}
+}
+
+// TestSQLNullIntBoolTime verifies NullInt64, NullBool, and NullTime are all
+// unwrapped the same way.
+func TestSQLNullIntBoolTime(t *testing.T) {
+	type Row struct {
+		Age    sql.NullInt64 `validate:"required"`
+		Active sql.NullBool  `validate:"required"`
+		SeenAt sql.NullTime  `validate:"required"`
+	}
+
+	invalid := Row{}
+	if errs := ValidateStruct(invalid); len(errs) != 3 {
+		t.Errorf("expected 3 errors for all-invalid Null* fields, got %d: %v", len(errs), errs)
+	}
+
+	valid := Row{
+		Age:    sql.NullInt64{Int64: 30, Valid: true},
+		Active: sql.NullBool{Bool: true, Valid: true},
+		SeenAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	if errs := ValidateStruct(valid); len(errs) != 0 {
+		t.Errorf("expected no error when all Null* fields are valid, got %v", errs)
+	}
+}
+
+// TestRegisterCustomTypeFunc verifies a user-registered wrapper type has its
+// underlying value validated by struct tags.
+func TestRegisterCustomTypeFunc(t *testing.T) {
+	type Cents int64
+	RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+		return int64(field.Interface().(Cents))
+	}, Cents(0))
+
+	type Price struct {
+		Amount Cents `validate:"min=1"`
+	}
+
+	if errs := ValidateStruct(Price{Amount: 0}); len(errs) != 1 {
+		t.Errorf("expected min=1 to fail for Cents(0), got %v", errs)
+	}
+	if errs := ValidateStruct(Price{Amount: 500}); len(errs) != 0 {
+		t.Errorf("expected no error for Cents(500), got %v", errs)
+	}
+}
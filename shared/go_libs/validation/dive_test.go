@@ -0,0 +1,89 @@
+package validation
+
+import "testing"
+
+// TestDiveSlice verifies dive reports indexed field paths like "Emails[2]"
+// for failures inside a []string field.
+func TestDiveSlice(t *testing.T) {
+	type Contact struct {
+		Emails []string `validate:"required,dive,email"`
+	}
+
+	errs := ValidateStruct(Contact{Emails: []string{"a@example.com", "not-an-email", "b@example.com"}})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "Emails[1]" {
+		t.Errorf("expected field 'Emails[1]', got %q", errs[0].Field)
+	}
+}
+
+// TestDiveNestedStruct verifies dive over a slice of structs prefixes the
+// index onto the nested field, matching the existing nested-struct behavior
+// shown in TestNestedStruct.
+func TestDiveNestedStruct(t *testing.T) {
+	type Address struct {
+		City string `validate:"required"`
+	}
+	type Person struct {
+		Addresses []Address `validate:"dive"`
+	}
+
+	errs := ValidateStruct(Person{Addresses: []Address{{City: "Anytown"}, {City: ""}}})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "Addresses[1].City" {
+		t.Errorf("expected field 'Addresses[1].City', got %q", errs[0].Field)
+	}
+}
+
+// TestDiveMap verifies dive over a map[string]T reports the string key
+// rather than a numeric index, e.g. "Contacts[home].Email".
+func TestDiveMap(t *testing.T) {
+	type Contact struct {
+		Email string `validate:"required,email"`
+	}
+	type Person struct {
+		Contacts map[string]Contact `validate:"dive"`
+	}
+
+	errs := ValidateStruct(Person{Contacts: map[string]Contact{
+		"home": {Email: "bad"},
+	}})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "Contacts[home].Email" {
+		t.Errorf("expected field 'Contacts[home].Email', got %q", errs[0].Field)
+	}
+}
+
+// TestDiveKeys verifies keys/endkeys validates map keys independently of
+// the map's values.
+func TestDiveKeys(t *testing.T) {
+	type Settings struct {
+		Flags map[string]bool `validate:"dive,keys,min=1,endkeys"`
+	}
+
+	errs := ValidateStruct(Settings{Flags: map[string]bool{"": true}})
+	if len(errs) == 0 {
+		t.Error("expected an error for an empty map key, got none")
+	}
+}
+
+// TestDiveMultiDimensional verifies nested dives on a [][]string report a
+// double-indexed path.
+func TestDiveMultiDimensional(t *testing.T) {
+	type Grid struct {
+		Rows [][]string `validate:"dive,dive,required"`
+	}
+
+	errs := ValidateStruct(Grid{Rows: [][]string{{"a", ""}}})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "Rows[0][1]" {
+		t.Errorf("expected field 'Rows[0][1]', got %q", errs[0].Field)
+	}
+}
@@ -0,0 +1,20 @@
+package validation
+
+// ValidationError represents a single field validation failure.
+type ValidationError struct {
+	Field   string
+	Message string
+	// Tag and Param are the failing validator tag and its parameter (if any),
+	// e.g. Tag "min" with Param "8". They let downstream code re-translate a
+	// failure without re-parsing Message.
+	Tag   string
+	Param string
+}
+
+// Error implements the error interface for ValidationError.
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
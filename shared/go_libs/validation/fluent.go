@@ -0,0 +1,137 @@
+package validation
+
+import "fmt"
+
+// Validation is a fluent, non-reflection alternative to struct tags, for
+// values that don't live on a tagged struct: query params, decoded JSON
+// maps, CLI flags. Each rule call appends to Errors using the same
+// ValidationError shape ValidateStruct returns, so output stays uniform
+// across both APIs. Modeled on Beego's Validation type.
+//
+// Example usage:
+//
+//	v := validation.New()
+//	v.Required(name, "name")
+//	v.MaxSize(name, 15, "nameMax")
+//	v.Range(age, 0, 140, "age")
+//	v.VillageID(id, "village")
+//	v.StrongPassword(pw, "password")
+//	if v.HasErrors() {
+//	    for _, err := range v.Errors {
+//	        fmt.Printf("Field: %s, Message: %s\n", err.Field, err.Message)
+//	    }
+//	}
+type Validation struct {
+	Errors      []ValidationError
+	stopOnFirst bool
+	stopped     bool
+}
+
+// New returns an empty Validation ready for chained rule calls.
+func New() *Validation {
+	return &Validation{}
+}
+
+// Stop switches the Validation into early-exit mode: once the first rule
+// fails, every subsequent rule call is skipped.
+func (v *Validation) Stop() *Validation {
+	v.stopOnFirst = true
+	return v
+}
+
+// HasErrors reports whether any rule has failed so far.
+func (v *Validation) HasErrors() bool {
+	return len(v.Errors) > 0
+}
+
+// Clear resets Errors and early-exit state so the Validation can be reused.
+func (v *Validation) Clear() {
+	v.Errors = nil
+	v.stopped = false
+}
+
+// checkTag runs tag against value via ValidateVar and records any failures
+// under key, so every fluent rule shares ValidateVar's validators and
+// messages instead of re-implementing them.
+func (v *Validation) checkTag(value interface{}, tag, key string) *Validation {
+	if v.stopOnFirst && v.stopped {
+		return v
+	}
+
+	for _, err := range ValidateVar(value, tag) {
+		v.Errors = append(v.Errors, ValidationError{
+			Field:   key,
+			Message: err.Message,
+			Tag:     err.Tag,
+			Param:   err.Param,
+		})
+		if v.stopOnFirst {
+			v.stopped = true
+			break
+		}
+	}
+
+	return v
+}
+
+// Required fails if value is the zero value for its type.
+func (v *Validation) Required(value interface{}, key string) *Validation {
+	return v.checkTag(value, "required", key)
+}
+
+// Email fails if value is not a valid email address.
+func (v *Validation) Email(value string, key string) *Validation {
+	return v.checkTag(value, "email", key)
+}
+
+// MinSize fails if value has fewer than min characters.
+func (v *Validation) MinSize(value string, min int, key string) *Validation {
+	return v.checkTag(value, fmt.Sprintf("min=%d", min), key)
+}
+
+// MaxSize fails if value has more than max characters.
+func (v *Validation) MaxSize(value string, max int, key string) *Validation {
+	return v.checkTag(value, fmt.Sprintf("max=%d", max), key)
+}
+
+// Range fails if value falls outside [min, max].
+func (v *Validation) Range(value int, min, max int, key string) *Validation {
+	return v.checkTag(value, fmt.Sprintf("min=%d,max=%d", min, max), key)
+}
+
+// VillageID fails if value is not a valid Elder Village ID (TTTT-OOOO-IIIIIIII).
+func (v *Validation) VillageID(value string, key string) *Validation {
+	return v.checkTag(value, "village_id", key)
+}
+
+// StrongPassword fails if value doesn't meet the strong_password requirements.
+func (v *Validation) StrongPassword(value string, key string) *Validation {
+	return v.checkTag(value, "strong_password", key)
+}
+
+// Password fails if value doesn't satisfy the named PasswordPolicy
+// (validate:"password=strict|medium|lax"); policy defaults to "medium".
+func (v *Validation) Password(value string, policy string, key string) *Validation {
+	tag := "password"
+	if policy != "" {
+		tag = "password=" + policy
+	}
+	return v.checkTag(value, tag, key)
+}
+
+// Custom runs fn for ad-hoc checks that don't map to a validator tag. A
+// non-nil error is recorded under key using the error's own message.
+func (v *Validation) Custom(fn func() error, key string) *Validation {
+	if v.stopOnFirst && v.stopped {
+		return v
+	}
+
+	if err := fn(); err != nil {
+		v.Errors = append(v.Errors, ValidationError{Field: key, Message: err.Error()})
+		if v.stopOnFirst {
+			v.stopped = true
+		}
+	}
+
+	return v
+}
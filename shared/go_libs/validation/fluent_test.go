@@ -0,0 +1,112 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidationFluentBasic exercises the fluent builder against a mix of
+// passing and failing rules.
+func TestValidationFluentBasic(t *testing.T) {
+	v := New()
+	v.Required("", "name")
+	v.MaxSize("this is too long", 5, "nameMax")
+	v.Range(200, 0, 140, "age")
+	v.VillageID("not-a-village-id", "village")
+	v.StrongPassword("weak", "password")
+
+	if !v.HasErrors() {
+		t.Fatal("expected errors")
+	}
+	if len(v.Errors) != 5 {
+		t.Fatalf("expected 5 errors, got %d: %v", len(v.Errors), v.Errors)
+	}
+
+	fields := map[string]bool{}
+	for _, e := range v.Errors {
+		fields[e.Field] = true
+	}
+	for _, key := range []string{"name", "nameMax", "age", "village", "password"} {
+		if !fields[key] {
+			t.Errorf("expected an error for field %q", key)
+		}
+	}
+}
+
+// TestValidationFluentValid verifies a fully valid set of calls produces no errors.
+func TestValidationFluentValid(t *testing.T) {
+	v := New()
+	v.Required("Jane", "name")
+	v.MaxSize("Jane", 15, "nameMax")
+	v.Range(30, 0, 140, "age")
+	v.VillageID("a1b2-c3d4-e5f67890", "village")
+	v.StrongPassword("MyP@ssw0rd", "password")
+
+	if v.HasErrors() {
+		t.Errorf("expected no errors, got %v", v.Errors)
+	}
+}
+
+// TestValidationStop verifies Stop() halts after the first failure.
+func TestValidationStop(t *testing.T) {
+	v := New().Stop()
+	v.Required("", "name")
+	v.Required("", "email")
+	v.Required("", "phone")
+
+	if len(v.Errors) != 1 {
+		t.Fatalf("expected exactly 1 error in stop mode, got %d: %v", len(v.Errors), v.Errors)
+	}
+	if v.Errors[0].Field != "name" {
+		t.Errorf("expected the first failing field 'name', got %q", v.Errors[0].Field)
+	}
+}
+
+// TestValidationCustom verifies ad-hoc checks via Custom.
+func TestValidationCustom(t *testing.T) {
+	v := New()
+	v.Custom(func() error { return nil }, "ok")
+	v.Custom(func() error { return errors.New("must be unique") }, "username")
+
+	if len(v.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(v.Errors), v.Errors)
+	}
+	if v.Errors[0].Field != "username" || v.Errors[0].Message != "must be unique" {
+		t.Errorf("unexpected error: %+v", v.Errors[0])
+	}
+}
+
+// TestValidationClear verifies Clear resets both errors and stop state.
+func TestValidationClear(t *testing.T) {
+	v := New().Stop()
+	v.Required("", "name")
+	if !v.HasErrors() {
+		t.Fatal("expected an error before Clear")
+	}
+
+	v.Clear()
+	if v.HasErrors() {
+		t.Errorf("expected no errors after Clear")
+	}
+
+	v.Required("", "name")
+	v.Required("", "email")
+	if len(v.Errors) != 1 {
+		t.Errorf("expected Stop mode to still apply after Clear, got %d errors", len(v.Errors))
+	}
+}
+
+// TestValidationPassword verifies the Password rule honors the policy param.
+func TestValidationPassword(t *testing.T) {
+	v := New()
+	v.Password("MyP@ssw0rd", "strict", "password")
+	if !v.HasErrors() {
+		t.Error("expected strict policy to reject a 10-character password")
+	}
+
+	v2 := New()
+	v2.Password("MyP@ssw0rd", "", "password")
+	if v2.HasErrors() {
+		t.Errorf("expected default (medium) policy to accept MyP@ssw0rd, got %v", v2.Errors)
+	}
+}
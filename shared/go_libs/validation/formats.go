@@ -0,0 +1,346 @@
+package validation
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// formatValidators are additional first-class format tags layered on top of
+// go-playground/validator's built-ins, following the same pattern as
+// village_id and strong_password: a validator.Func plus a friendly message
+// in formatValidationMessage.
+var formatValidators = map[string]validator.Func{
+	"isbn":             validateISBN,
+	"isbn10":           validateISBN10,
+	"isbn13":           validateISBN13,
+	"uuid":             validateUUIDAnyVersion,
+	"uuid3":            validateUUIDVersion(3),
+	"uuid4":            validateUUIDVersion(4),
+	"uuid5":            validateUUIDVersion(5),
+	"ascii":            validateASCII,
+	"printascii":       validatePrintableASCII,
+	"datauri":          validateDataURI,
+	"latitude":         validateLatitude,
+	"longitude":        validateLongitude,
+	"ssn":              validateSSN,
+	"semver":           validateSemver,
+	"creditcard":       validateCreditCard,
+	"mac":              validateMAC,
+	"cidr":             validateCIDR,
+	"e164":             validateE164,
+	"hexcolor":         validateHexColor,
+	"rgb":              validateRGB,
+	"rgba":             validateRGBA,
+	"hsl":              validateHSL,
+	"hsla":             validateHSLA,
+	"hostname":         validateHostname,
+	"hostname_rfc1123": validateHostnameRFC1123,
+	"alphaunicode":     validateAlphaUnicode,
+	"base64":           validateBase64,
+	"hexadecimal":      validateHexadecimal,
+	"datetime":         validateDatetime,
+	"iso3166_1_alpha2": validateISO3166Alpha2,
+	"multibyte":        validateMultibyte,
+}
+
+var (
+	isbn10Pattern          = regexp.MustCompile(`^(?:[0-9]{9}X|[0-9]{10})$`)
+	isbn13Pattern          = regexp.MustCompile(`^97[89][0-9]{10}$`)
+	uuidPattern            = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	dataURIPattern         = regexp.MustCompile(`^data:[a-zA-Z0-9!#$&.+\-^_]+/[a-zA-Z0-9!#$&.+\-^_]+;base64,[a-zA-Z0-9+/]+=*$`)
+	ssnPattern             = regexp.MustCompile(`^\d{3}-\d{2}-\d{4}$`)
+	semverPattern          = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-[0-9A-Za-z.-]+)?(?:\+[0-9A-Za-z.-]+)?$`)
+	e164Pattern            = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	hexColorPattern        = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	rgbPattern             = regexp.MustCompile(`^rgb\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*\)$`)
+	rgbaPattern            = regexp.MustCompile(`^rgba\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d*\.?\d+)\s*\)$`)
+	hslPattern             = regexp.MustCompile(`^hsl\(\s*(\d{1,3})\s*,\s*(\d{1,3})%\s*,\s*(\d{1,3})%\s*\)$`)
+	hslaPattern            = regexp.MustCompile(`^hsla\(\s*(\d{1,3})\s*,\s*(\d{1,3})%\s*,\s*(\d{1,3})%\s*,\s*(\d*\.?\d+)\s*\)$`)
+	hostnamePattern        = regexp.MustCompile(`^[a-zA-Z](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?(?:\.[a-zA-Z](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?)*$`)
+	hostnameRFC1123Pattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9\-]*[a-zA-Z0-9])?)*$`)
+	base64Pattern          = regexp.MustCompile(`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`)
+	hexadecimalPattern     = regexp.MustCompile(`^(?:0[xX])?[0-9a-fA-F]+$`)
+	iso3166Alpha2Pattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// registerFormatValidators registers every tag in formatValidators on v.
+func registerFormatValidators(v *validator.Validate) {
+	for tag, fn := range formatValidators {
+		if err := v.RegisterValidation(tag, fn); err != nil && !strings.Contains(err.Error(), "already exists") {
+			fmt.Printf("Failed to register %s validator: %v\n", tag, err)
+		}
+	}
+}
+
+func validateISBN(fl validator.FieldLevel) bool {
+	return validateISBN10(fl) || validateISBN13(fl)
+}
+
+func validateISBN10(fl validator.FieldLevel) bool {
+	s := normalizeISBN(fl.Field().String())
+	if !isbn10Pattern.MatchString(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(s[i]-'0') * (10 - i)
+	}
+	if s[9] == 'X' {
+		sum += 10
+	} else {
+		sum += int(s[9] - '0')
+	}
+	return sum%11 == 0
+}
+
+func validateISBN13(fl validator.FieldLevel) bool {
+	s := normalizeISBN(fl.Field().String())
+	if !isbn13Pattern.MatchString(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 12; i++ {
+		digit := int(s[i] - '0')
+		if i%2 == 0 {
+			sum += digit
+		} else {
+			sum += digit * 3
+		}
+	}
+	checksum := (10 - sum%10) % 10
+	return checksum == int(s[12]-'0')
+}
+
+func normalizeISBN(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.ReplaceAll(s, "-", ""), " ", ""))
+}
+
+func validateUUIDAnyVersion(fl validator.FieldLevel) bool {
+	return uuidPattern.MatchString(fl.Field().String())
+}
+
+// validateUUIDVersion returns a validator.Func that checks both the UUID
+// shape and the version nibble (the first hex digit of the third group).
+func validateUUIDVersion(version byte) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		s := fl.Field().String()
+		if !uuidPattern.MatchString(s) {
+			return false
+		}
+		return s[14] == '0'+version
+	}
+}
+
+func validateASCII(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	for i := 0; i < len(s); i++ {
+		if s[i] > 127 {
+			return false
+		}
+	}
+	return true
+}
+
+func validatePrintableASCII(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	for i := 0; i < len(s); i++ {
+		if s[i] < 32 || s[i] > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+func validateDataURI(fl validator.FieldLevel) bool {
+	return dataURIPattern.MatchString(fl.Field().String())
+}
+
+func validateLatitude(fl validator.FieldLevel) bool {
+	v, err := strconv.ParseFloat(fl.Field().String(), 64)
+	if err != nil {
+		return false
+	}
+	return v >= -90 && v <= 90
+}
+
+func validateLongitude(fl validator.FieldLevel) bool {
+	v, err := strconv.ParseFloat(fl.Field().String(), 64)
+	if err != nil {
+		return false
+	}
+	return v >= -180 && v <= 180
+}
+
+func validateSSN(fl validator.FieldLevel) bool {
+	return ssnPattern.MatchString(fl.Field().String())
+}
+
+func validateSemver(fl validator.FieldLevel) bool {
+	return semverPattern.MatchString(fl.Field().String())
+}
+
+// validateCreditCard applies the Luhn checksum to a digit-only card number
+// (dashes and spaces are stripped before checking).
+func validateCreditCard(fl validator.FieldLevel) bool {
+	s := strings.NewReplacer("-", "", " ", "").Replace(fl.Field().String())
+	if len(s) < 12 || len(s) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+		digit := int(s[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func validateMAC(fl validator.FieldLevel) bool {
+	_, err := net.ParseMAC(fl.Field().String())
+	return err == nil
+}
+
+func validateCIDR(fl validator.FieldLevel) bool {
+	_, _, err := net.ParseCIDR(fl.Field().String())
+	return err == nil
+}
+
+func validateE164(fl validator.FieldLevel) bool {
+	return e164Pattern.MatchString(fl.Field().String())
+}
+
+func validateHexColor(fl validator.FieldLevel) bool {
+	return hexColorPattern.MatchString(fl.Field().String())
+}
+
+func validateRGB(fl validator.FieldLevel) bool {
+	m := rgbPattern.FindStringSubmatch(fl.Field().String())
+	if m == nil {
+		return false
+	}
+	return in8Bit(m[1]) && in8Bit(m[2]) && in8Bit(m[3])
+}
+
+func validateRGBA(fl validator.FieldLevel) bool {
+	m := rgbaPattern.FindStringSubmatch(fl.Field().String())
+	if m == nil {
+		return false
+	}
+	if !(in8Bit(m[1]) && in8Bit(m[2]) && in8Bit(m[3])) {
+		return false
+	}
+	alpha, err := strconv.ParseFloat(m[4], 64)
+	return err == nil && alpha >= 0 && alpha <= 1
+}
+
+func validateHSL(fl validator.FieldLevel) bool {
+	m := hslPattern.FindStringSubmatch(fl.Field().String())
+	if m == nil {
+		return false
+	}
+	return inHueRange(m[1]) && inPercentRange(m[2]) && inPercentRange(m[3])
+}
+
+func validateHSLA(fl validator.FieldLevel) bool {
+	m := hslaPattern.FindStringSubmatch(fl.Field().String())
+	if m == nil {
+		return false
+	}
+	if !(inHueRange(m[1]) && inPercentRange(m[2]) && inPercentRange(m[3])) {
+		return false
+	}
+	alpha, err := strconv.ParseFloat(m[4], 64)
+	return err == nil && alpha >= 0 && alpha <= 1
+}
+
+func in8Bit(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 255
+}
+
+func inPercentRange(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 100
+}
+
+func inHueRange(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 360
+}
+
+// validateHostname enforces RFC 952 hostname rules: labels must start with a
+// letter and end with a letter or digit, dashes allowed in between,
+// dot-separated.
+func validateHostname(fl validator.FieldLevel) bool {
+	return hostnamePattern.MatchString(fl.Field().String())
+}
+
+// validateHostnameRFC1123 relaxes validateHostname to RFC 1123, allowing
+// labels to start with a digit (e.g. "3rd.example.com").
+func validateHostnameRFC1123(fl validator.FieldLevel) bool {
+	return hostnameRFC1123Pattern.MatchString(fl.Field().String())
+}
+
+func validateAlphaUnicode(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateBase64(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	return s != "" && len(s)%4 == 0 && base64Pattern.MatchString(s)
+}
+
+func validateHexadecimal(fl validator.FieldLevel) bool {
+	return hexadecimalPattern.MatchString(fl.Field().String())
+}
+
+// validateDatetime parses the field's string value with time.Parse using
+// the tag's param as the Go reference layout, e.g. `validate:"datetime=2006-01-02"`.
+func validateDatetime(fl validator.FieldLevel) bool {
+	_, err := time.Parse(fl.Param(), fl.Field().String())
+	return err == nil
+}
+
+// validateISO3166Alpha2 checks for the shape of an ISO 3166-1 alpha-2
+// country code (two uppercase letters); it does not check against the
+// actual assigned-code list.
+func validateISO3166Alpha2(fl validator.FieldLevel) bool {
+	return iso3166Alpha2Pattern.MatchString(fl.Field().String())
+}
+
+// validateMultibyte reports whether the field contains at least one
+// multi-byte (non-ASCII) rune.
+func validateMultibyte(fl validator.FieldLevel) bool {
+	for _, r := range fl.Field().String() {
+		if r > 127 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package validation
+
+import "testing"
+
+// TestFormatValidators table-tests the tags in formatValidators with accepted
+// and rejected inputs. uuid3 has no row here and uuid5 only an accepted one;
+// datetime takes a layout param and is tested in TestDatetimeValidator.
+func TestFormatValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		value     string
+		expectErr bool
+	}{
+		{"isbn10 valid", "isbn10", "0-306-40615-2", false},
+		{"isbn10 invalid checksum", "isbn10", "0-306-40615-3", true},
+		{"isbn13 valid", "isbn13", "978-0-306-40615-7", false},
+		{"isbn13 invalid checksum", "isbn13", "978-0-306-40615-8", true},
+		{"isbn valid 10", "isbn", "0-306-40615-2", false},
+		{"isbn valid 13", "isbn", "978-0-306-40615-7", false},
+		{"isbn invalid", "isbn", "not-an-isbn", true},
+		{"isbn invalid 13 checksum", "isbn", "9780000000000", true},
+		{"uuid valid", "uuid", "123e4567-e89b-12d3-a456-426614174000", false},
+		{"uuid invalid", "uuid", "not-a-uuid", true},
+		{"uuid4 valid", "uuid4", "123e4567-e89b-42d3-a456-426614174000", false},
+		{"uuid4 wrong version", "uuid4", "123e4567-e89b-12d3-a456-426614174000", true},
+		{"uuid5 valid", "uuid5", "123e4567-e89b-52d3-a456-426614174000", false},
+		{"ascii valid", "ascii", "Hello World 123", false},
+		{"ascii invalid", "ascii", "café", true},
+		{"printascii valid", "printascii", "Hello!", false},
+		{"printascii invalid newline", "printascii", "Hello\n", true},
+		{"datauri valid", "datauri", "data:text/plain;base64,SGVsbG8=", false},
+		{"datauri invalid", "datauri", "not-a-data-uri", true},
+		{"latitude valid", "latitude", "-90.000", false},
+		{"latitude valid upper bound", "latitude", "90", false},
+		{"latitude invalid", "latitude", "99.9", true},
+		{"latitude invalid out of range", "latitude", "108", true},
+		{"longitude valid", "longitude", "-180", false},
+		{"longitude invalid", "longitude", "181.5", true},
+		{"ssn valid", "ssn", "123-45-6789", false},
+		{"ssn invalid", "ssn", "123456789", true},
+		{"semver valid", "semver", "1.2.3", false},
+		{"semver valid with prerelease", "semver", "1.2.3-beta.1+build.5", false},
+		{"semver invalid", "semver", "1.2", true},
+		{"creditcard valid visa", "creditcard", "4532015112830366", false},
+		{"creditcard invalid", "creditcard", "1234567890123456", true},
+		{"mac valid", "mac", "01:23:45:67:89:ab", false},
+		{"mac invalid", "mac", "not-a-mac", true},
+		{"cidr valid", "cidr", "192.168.1.0/24", false},
+		{"cidr invalid", "cidr", "192.168.1.0", true},
+		{"e164 valid", "e164", "+14155552671", false},
+		{"e164 invalid", "e164", "14155552671", true},
+		{"hexcolor valid", "hexcolor", "#FF5733", false},
+		{"hexcolor valid short", "hexcolor", "#fff", false},
+		{"hexcolor invalid", "hexcolor", "FF5733", true},
+		{"rgb valid", "rgb", "rgb(255, 0, 128)", false},
+		{"rgb invalid", "rgb", "rgb(256, 0, 0)", true},
+		{"rgba valid", "rgba", "rgba(255, 0, 128, 0.5)", false},
+		{"rgba invalid alpha", "rgba", "rgba(255, 0, 128, 1.5)", true},
+		{"hsl valid", "hsl", "hsl(360, 100%, 50%)", false},
+		{"hsl invalid", "hsl", "hsl(361, 100%, 50%)", true},
+		{"hsla valid", "hsla", "hsla(120, 50%, 50%, 0.8)", false},
+		{"hsla invalid", "hsla", "hsla(120, 50%, 50%, 1.8)", true},
+		{"hostname valid", "hostname", "api.example.com", false},
+		{"hostname invalid leading digit", "hostname", "3api.example.com", true},
+		{"hostname_rfc1123 valid leading digit", "hostname_rfc1123", "3api.example.com", false},
+		{"hostname_rfc1123 invalid", "hostname_rfc1123", "-bad-.example.com", true},
+		{"alphaunicode valid", "alphaunicode", "café", false},
+		{"alphaunicode invalid digits", "alphaunicode", "cafe123", true},
+		{"base64 valid", "base64", "SGVsbG8=", false},
+		{"base64 invalid", "base64", "not base64!", true},
+		{"hexadecimal valid", "hexadecimal", "0xFF5733", false},
+		{"hexadecimal invalid", "hexadecimal", "not-hex", true},
+		{"iso3166_1_alpha2 valid", "iso3166_1_alpha2", "US", false},
+		{"iso3166_1_alpha2 invalid", "iso3166_1_alpha2", "USA", true},
+		{"multibyte valid", "multibyte", "héllo", false},
+		{"multibyte invalid ascii-only", "multibyte", "hello", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateVar(tt.value, tt.tag)
+			if tt.expectErr && len(errs) == 0 {
+				t.Errorf("ValidateVar(%q, %q): expected error, got none", tt.value, tt.tag)
+			}
+			if !tt.expectErr && len(errs) > 0 {
+				t.Errorf("ValidateVar(%q, %q): expected no error, got %v", tt.value, tt.tag, errs)
+			}
+		})
+	}
+}
+
+// TestDatetimeValidator exercises the datetime tag, which takes its layout
+// as a tag param rather than being a fixed-pattern format tag.
+func TestDatetimeValidator(t *testing.T) {
+	if errs := ValidateVar("2026-07-29", "datetime=2006-01-02"); len(errs) != 0 {
+		t.Errorf("expected a matching date to pass, got %v", errs)
+	}
+	if errs := ValidateVar("07/29/2026", "datetime=2006-01-02"); len(errs) != 1 {
+		t.Errorf("expected a non-matching date to fail, got %v", errs)
+	}
+}
@@ -0,0 +1,102 @@
+// Package middleware wires the validation package into an HTTP request
+// pipeline: decode the request body into a struct, run ValidateStruct on it,
+// and either hand the validated value to the next handler or respond with a
+// 400 describing every failing field.
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	"github.com/penguintechinc/elder/shared/go_libs/validation"
+)
+
+// contextKey is unexported so values stashed by this package can't collide
+// with keys set by other packages, matching the pattern validation.WithLocale
+// already uses for its own context key.
+type contextKey struct{}
+
+// validatedKey is the context key Bind stores the decoded, validated value
+// under.
+var validatedKey = contextKey{}
+
+// errorResponse is the JSON body written on a validation failure.
+type errorResponse struct {
+	Errors []fieldError `json:"errors"`
+}
+
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Bind decodes each request's JSON body into a fresh copy of target's type,
+// validates it with validation.ValidateStruct, and either calls next with
+// the validated value stashed in the request context (retrievable via
+// Validated) or writes a 400 with a JSON {"errors": [...]} body built from
+// the failing ValidationErrors.
+//
+// Example usage:
+//
+//	http.Handle("/signup", middleware.Bind(SignupRequest{})(signupHandler))
+func Bind(target interface{}) func(http.Handler) http.Handler {
+	targetType := reflect.TypeOf(target)
+	if targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := reflect.New(targetType)
+
+			if err := json.NewDecoder(r.Body).Decode(value.Interface()); err != nil {
+				writeErrors(w, []validation.ValidationError{{Message: "invalid JSON body: " + err.Error()}})
+				return
+			}
+
+			if errs := validation.ValidateStruct(value.Interface()); len(errs) > 0 {
+				writeErrors(w, errs)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), validatedKey, value.Interface())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Validated retrieves the value Bind stashed in ctx, for handlers that need
+// the already-decoded-and-validated request body. The returned value is a
+// pointer to the type passed to Bind; ok is false if Bind never ran.
+func Validated(ctx context.Context) (value interface{}, ok bool) {
+	value = ctx.Value(validatedKey)
+	return value, value != nil
+}
+
+// BindHandlerFunc adapts Bind for Gin/Echo-style frameworks whose handler
+// signature is func(w http.ResponseWriter, r *http.Request, validated interface{}),
+// rather than reaching into the context themselves. Most Go web frameworks
+// expose a way to wrap their own handler type around a plain net/http
+// handler, so this stays framework-agnostic rather than importing gin or
+// echo directly.
+func BindHandlerFunc(target interface{}, handler func(w http.ResponseWriter, r *http.Request, validated interface{})) http.Handler {
+	return Bind(target)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validated, _ := Validated(r.Context())
+		handler(w, r, validated)
+	}))
+}
+
+// writeErrors writes a 400 response with a JSON body of {"errors": [...]}
+// built from errs.
+func writeErrors(w http.ResponseWriter, errs []validation.ValidationError) {
+	body := errorResponse{Errors: make([]fieldError, 0, len(errs))}
+	for _, e := range errs {
+		body.Errors = append(body.Errors, fieldError{Field: e.Field, Message: e.Message})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type signupRequest struct {
+	Email string `validate:"required,email"`
+	Name  string `validate:"required,min=3"`
+}
+
+// TestBindValidRequest verifies a well-formed body reaches the next handler
+// with the validated value available via Validated.
+func TestBindValidRequest(t *testing.T) {
+	var gotEmail string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		validated, ok := Validated(r.Context())
+		if !ok {
+			t.Fatal("expected a validated value in context")
+		}
+		gotEmail = validated.(*signupRequest).Email
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{"email":"jdoe@example.com","name":"Jane"}`))
+	rec := httptest.NewRecorder()
+
+	Bind(signupRequest{})(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", rec.Code)
+	}
+	if gotEmail != "jdoe@example.com" {
+		t.Errorf("expected email 'jdoe@example.com', got %q", gotEmail)
+	}
+}
+
+// TestBindInvalidBody verifies a 400 with a field-keyed error body on
+// validation failure, and that next is never called.
+func TestBindInvalidBody(t *testing.T) {
+	called := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{"email":"not-an-email","name":"Jo"}`))
+	rec := httptest.NewRecorder()
+
+	Bind(signupRequest{})(next).ServeHTTP(rec, req)
+
+	if called {
+		t.Error("expected next not to be called for an invalid body")
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rec.Code)
+	}
+
+	var body errorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected valid JSON error body, got error: %v", err)
+	}
+	if len(body.Errors) == 0 {
+		t.Error("expected at least one field error")
+	}
+}
+
+// TestBindMalformedJSON verifies undecodable JSON also produces a 400.
+func TestBindMalformedJSON(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected next not to be called for malformed JSON")
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`not-json`))
+	rec := httptest.NewRecorder()
+
+	Bind(signupRequest{})(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400, got %d", rec.Code)
+	}
+}
+
+// TestBindHandlerFunc verifies the Gin/Echo-friendly adapter passes the
+// validated value directly to the handler.
+func TestBindHandlerFunc(t *testing.T) {
+	var gotName string
+	handler := BindHandlerFunc(signupRequest{}, func(w http.ResponseWriter, r *http.Request, validated interface{}) {
+		gotName = validated.(*signupRequest).Name
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(`{"email":"jdoe@example.com","name":"Jane"}`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if gotName != "Jane" {
+		t.Errorf("expected name 'Jane', got %q", gotName)
+	}
+}
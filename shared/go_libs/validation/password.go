@@ -0,0 +1,271 @@
+package validation
+
+import (
+	_ "embed"
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+//go:embed data/common_passwords.txt
+var commonPasswordsData string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswords     map[string]struct{}
+)
+
+// loadCommonPasswords lazily parses the embedded common-password list into a set.
+func loadCommonPasswords() map[string]struct{} {
+	commonPasswordsOnce.Do(func() {
+		lines := strings.Split(commonPasswordsData, "\n")
+		commonPasswords = make(map[string]struct{}, len(lines))
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			commonPasswords[strings.ToLower(line)] = struct{}{}
+		}
+	})
+	return commonPasswords
+}
+
+// BreachChecker looks up whether a password has appeared in a known breach,
+// e.g. via a k-anonymity HIBP-style range query. Implementations should treat
+// network errors as "unknown" rather than "breached" and surface them via err.
+type BreachChecker interface {
+	IsBreached(password string) (bool, error)
+}
+
+// PasswordPolicy describes the rules ValidatePassword enforces.
+type PasswordPolicy struct {
+	MinLength               int
+	MinEntropyBits          float64
+	MinCharClasses          int
+	DisallowCommonPasswords bool
+	DisallowUserContext     []string
+	BreachChecker           BreachChecker
+}
+
+// PolicyOption mutates a PasswordPolicy. Use the With* constructors below.
+type PolicyOption func(*PasswordPolicy)
+
+// WithMinLength sets the minimum accepted password length.
+func WithMinLength(n int) PolicyOption {
+	return func(p *PasswordPolicy) { p.MinLength = n }
+}
+
+// WithMinEntropyBits sets the minimum estimated entropy, in bits.
+func WithMinEntropyBits(bits float64) PolicyOption {
+	return func(p *PasswordPolicy) { p.MinEntropyBits = bits }
+}
+
+// WithMinCharClasses sets how many of {upper, lower, digit, special} must be present.
+func WithMinCharClasses(n int) PolicyOption {
+	return func(p *PasswordPolicy) { p.MinCharClasses = n }
+}
+
+// WithDisallowCommonPasswords toggles rejection of passwords found in the
+// embedded top-N common-password list.
+func WithDisallowCommonPasswords(disallow bool) PolicyOption {
+	return func(p *PasswordPolicy) { p.DisallowCommonPasswords = disallow }
+}
+
+// WithDisallowUserContext rejects passwords containing any of the given
+// substrings (case-insensitive), typically the user's name, email, or username.
+func WithDisallowUserContext(substrings ...string) PolicyOption {
+	return func(p *PasswordPolicy) { p.DisallowUserContext = substrings }
+}
+
+// WithBreachChecker wires in a BreachChecker (e.g. a k-anonymity HIBP lookup)
+// consulted in addition to the embedded common-password list.
+func WithBreachChecker(checker BreachChecker) PolicyOption {
+	return func(p *PasswordPolicy) { p.BreachChecker = checker }
+}
+
+// StrictPolicy requires a long, high-entropy password using all character
+// classes and rejects passwords on the embedded common-password list. It sets
+// no BreachChecker, so breached passwords are rejected only once one is set.
+func StrictPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:               12,
+		MinEntropyBits:          60,
+		MinCharClasses:          4,
+		DisallowCommonPasswords: true,
+	}
+}
+
+// MediumPolicy mirrors the historical strong_password behavior: 8 characters
+// minimum using all four character classes.
+func MediumPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:               8,
+		MinEntropyBits:          0,
+		MinCharClasses:          4,
+		DisallowCommonPasswords: true,
+	}
+}
+
+// LaxPolicy only requires a minimum length and two character classes.
+func LaxPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:               6,
+		MinEntropyBits:          0,
+		MinCharClasses:          2,
+		DisallowCommonPasswords: false,
+	}
+}
+
+// PasswordResult is the outcome of ValidatePassword: a 0-100 score, an
+// estimated entropy in bits, and the names of any rules that failed.
+type PasswordResult struct {
+	Valid       bool
+	Score       int
+	EntropyBits float64
+	Failures    []string
+}
+
+const passwordSpecialChars = "!@#$%^&*()_+-=[]{}|;:,.<>?/~`"
+
+// charClassPoolSize returns the estimated alphabet size used by pw, for a
+// simple entropy-bits-per-character estimate.
+func charClassPoolSize(pw string) (pool int, classes int) {
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, r := range pw {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case contains(passwordSpecialChars, r):
+			hasSpecial = true
+		}
+	}
+	if hasUpper {
+		pool += 26
+		classes++
+	}
+	if hasLower {
+		pool += 26
+		classes++
+	}
+	if hasDigit {
+		pool += 10
+		classes++
+	}
+	if hasSpecial {
+		pool += len([]rune(passwordSpecialChars))
+		classes++
+	}
+	return pool, classes
+}
+
+// estimatePasswordEntropy returns a rough entropy estimate in bits:
+// length * log2(alphabet size), the standard back-of-envelope formula.
+func estimatePasswordEntropy(pw string) float64 {
+	pool, _ := charClassPoolSize(pw)
+	if pool == 0 || len(pw) == 0 {
+		return 0
+	}
+	return float64(len([]rune(pw))) * math.Log2(float64(pool))
+}
+
+// ValidatePassword scores pw against a PasswordPolicy built from the given
+// options (starting from MediumPolicy). It never returns an error; callers
+// inspect PasswordResult.Valid and PasswordResult.Failures.
+func ValidatePassword(pw string, opts ...PolicyOption) PasswordResult {
+	policy := MediumPolicy()
+	for _, opt := range opts {
+		opt(&policy)
+	}
+	return validateAgainstPolicy(pw, policy)
+}
+
+func validateAgainstPolicy(pw string, policy PasswordPolicy) PasswordResult {
+	var failures []string
+
+	if policy.MinLength > 0 && len([]rune(pw)) < policy.MinLength {
+		failures = append(failures, "min_length")
+	}
+
+	_, classes := charClassPoolSize(pw)
+	if policy.MinCharClasses > 0 && classes < policy.MinCharClasses {
+		failures = append(failures, "char_classes")
+	}
+
+	entropy := estimatePasswordEntropy(pw)
+	if policy.MinEntropyBits > 0 && entropy < policy.MinEntropyBits {
+		failures = append(failures, "min_entropy")
+	}
+
+	if policy.DisallowCommonPasswords {
+		if _, found := loadCommonPasswords()[strings.ToLower(pw)]; found {
+			failures = append(failures, "common_password")
+		}
+	}
+
+	for _, ctx := range policy.DisallowUserContext {
+		ctx = strings.TrimSpace(ctx)
+		if ctx != "" && strings.Contains(strings.ToLower(pw), strings.ToLower(ctx)) {
+			failures = append(failures, "user_context")
+			break
+		}
+	}
+
+	if policy.BreachChecker != nil {
+		if breached, err := policy.BreachChecker.IsBreached(pw); err == nil && breached {
+			failures = append(failures, "breached_password")
+		}
+	}
+
+	score := passwordScore(len(failures), entropy, classes)
+
+	return PasswordResult{
+		Valid:       len(failures) == 0,
+		Score:       score,
+		EntropyBits: entropy,
+		Failures:    failures,
+	}
+}
+
+// passwordScore maps entropy/class data to a 0-100 score, penalized per
+// failed rule so a policy violation always drags the score down.
+func passwordScore(failureCount int, entropy float64, classes int) int {
+	score := int(entropy) + classes*5
+	score -= failureCount * 20
+	if score < 0 {
+		score = 0
+	}
+	if score > 100 {
+		score = 100
+	}
+	return score
+}
+
+// policyForTagParam resolves the "password=strict|medium|lax" tag
+// param to a PasswordPolicy, defaulting to MediumPolicy for an empty or
+// unrecognized param so existing `validate:"password"` usages keep working.
+func policyForTagParam(param string) PasswordPolicy {
+	switch param {
+	case "strict":
+		return StrictPolicy()
+	case "lax":
+		return LaxPolicy()
+	default:
+		return MediumPolicy()
+	}
+}
+
+// validatePasswordPolicyTag backs the "password" validator tag, e.g.
+// `validate:"password=strict"`. It replaces strong_password's boolean check
+// with the full PasswordPolicy engine while keeping the same FieldLevel shape.
+func validatePasswordPolicyTag(fl validator.FieldLevel) bool {
+	result := validateAgainstPolicy(fl.Field().String(), policyForTagParam(fl.Param()))
+	return result.Valid
+}
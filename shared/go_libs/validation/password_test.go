@@ -0,0 +1,141 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidatePasswordPolicies tests ValidatePassword against the three
+// built-in policies.
+func TestValidatePasswordPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		opts     []PolicyOption
+		wantOK   bool
+	}{
+		{
+			name:     "medium policy valid",
+			password: "MyP@ssw0rd",
+			opts:     nil,
+			wantOK:   true,
+		},
+		{
+			name:     "medium policy missing digit",
+			password: "MyP@ssword",
+			opts:     nil,
+			wantOK:   false,
+		},
+		{
+			name:     "strict policy short password fails",
+			password: "MyP@ssw0rd",
+			opts:     []PolicyOption{func(p *PasswordPolicy) { *p = StrictPolicy() }},
+			wantOK:   false,
+		},
+		{
+			name:     "strict policy long high-entropy password passes",
+			password: "Tr0ub4dor&Xylophone!9",
+			opts:     []PolicyOption{func(p *PasswordPolicy) { *p = StrictPolicy() }},
+			wantOK:   true,
+		},
+		{
+			name:     "lax policy accepts two classes",
+			password: "lowercase123",
+			opts:     []PolicyOption{func(p *PasswordPolicy) { *p = LaxPolicy() }},
+			wantOK:   true,
+		},
+		{
+			name:     "common password rejected",
+			password: "password1",
+			opts:     []PolicyOption{WithMinCharClasses(1), WithMinLength(1)},
+			wantOK:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidatePassword(tt.password, tt.opts...)
+			if result.Valid != tt.wantOK {
+				t.Errorf("ValidatePassword(%q) valid = %v, want %v (failures: %v)", tt.password, result.Valid, tt.wantOK, result.Failures)
+			}
+		})
+	}
+}
+
+// TestValidatePasswordUserContext ensures user-context substrings are rejected.
+func TestValidatePasswordUserContext(t *testing.T) {
+	result := ValidatePassword("JohnSmith123!", WithDisallowUserContext("johnsmith"))
+	if result.Valid {
+		t.Errorf("expected password containing user context to fail, got valid result: %+v", result)
+	}
+
+	found := false
+	for _, f := range result.Failures {
+		if f == "user_context" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected user_context failure, got %v", result.Failures)
+	}
+}
+
+// TestValidatePasswordBreachChecker ensures a registered BreachChecker is consulted.
+func TestValidatePasswordBreachChecker(t *testing.T) {
+	checker := breachCheckerFunc(func(pw string) (bool, error) {
+		return pw == "Leaked123!", nil
+	})
+
+	result := ValidatePassword("Leaked123!", WithBreachChecker(checker))
+	if result.Valid {
+		t.Errorf("expected breached password to fail")
+	}
+
+	result = ValidatePassword("NotLeaked456!", WithBreachChecker(checker))
+	if !result.Valid {
+		t.Errorf("expected non-breached password to pass, got failures: %v", result.Failures)
+	}
+}
+
+// TestValidatePasswordBreachCheckerError ensures a checker error does not
+// itself fail validation (network errors should not become false positives).
+func TestValidatePasswordBreachCheckerError(t *testing.T) {
+	checker := breachCheckerFunc(func(pw string) (bool, error) {
+		return false, errors.New("lookup unavailable")
+	})
+
+	result := ValidatePassword("MyP@ssw0rd", WithBreachChecker(checker))
+	if !result.Valid {
+		t.Errorf("expected checker error to be ignored, got failures: %v", result.Failures)
+	}
+}
+
+// TestPasswordTagPolicyParam tests the password tag's policy param via ValidateStruct.
+func TestPasswordTagPolicyParam(t *testing.T) {
+	type StrictAccount struct {
+		Password string `validate:"password=strict"`
+	}
+	type MediumAccount struct {
+		Password string `validate:"password=medium"`
+	}
+	type LaxAccount struct {
+		Password string `validate:"password=lax"`
+	}
+
+	if errs := ValidateStruct(StrictAccount{Password: "MyP@ssw0rd"}); len(errs) == 0 {
+		t.Errorf("expected strict policy to reject a short password")
+	}
+	if errs := ValidateStruct(MediumAccount{Password: "MyP@ssw0rd"}); len(errs) != 0 {
+		t.Errorf("expected medium policy to accept MyP@ssw0rd, got %v", errs)
+	}
+	if errs := ValidateStruct(LaxAccount{Password: "lowercase1"}); len(errs) != 0 {
+		t.Errorf("expected lax policy to accept lowercase1, got %v", errs)
+	}
+}
+
+// breachCheckerFunc adapts a function to the BreachChecker interface for tests.
+type breachCheckerFunc func(password string) (bool, error)
+
+func (f breachCheckerFunc) IsBreached(password string) (bool, error) {
+	return f(password)
+}
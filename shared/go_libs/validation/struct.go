@@ -27,6 +27,25 @@ func getValidator() *validator.Validate {
 // Currently registered validators:
 //   - village_id: Validates Elder Village ID format (TTTT-OOOO-IIIIIIII)
 //   - strong_password: Validates password strength (min 8 chars, uppercase, lowercase, digit, special char)
+//   - password: Validates password strength via the PasswordPolicy engine; accepts
+//     an optional policy param, e.g. validate:"password=strict|medium|lax" (default medium)
+//   - a batch of format validators (isbn, isbn10, isbn13, uuid, uuid3, uuid4, uuid5,
+//     ascii, printascii, datauri, latitude, longitude, ssn, semver, creditcard, mac,
+//     cidr, e164, hexcolor, rgb, rgba, hsl, hsla, hostname, hostname_rfc1123,
+//     alphaunicode, base64, hexadecimal, datetime, iso3166_1_alpha2, multibyte);
+//     see formats.go
+//   - url, uri, ip, ipv4, ipv6, alpha, and alphanum are go-playground/validator
+//     built-ins; only their friendly messages live in this package
+//   - dotted-path-aware eqfield/nefield/gtfield/ltfield and required_if/
+//     required_unless/required_with; see crossfield.go
+//
+// dive/keys/endkeys on slice, array, and map fields are handled natively by
+// go-playground/validator; ValidationError.Field reports the resulting
+// indexed path (e.g. "Emails[2]", "Contacts[home].Email") via
+// relativeFieldPath below rather than just the leaf field name.
+//   - sql.NullString, sql.NullInt64, sql.NullBool, and sql.NullTime are
+//     unwrapped to their underlying value (or treated as absent when
+//     Valid=false); see customtype.go and RegisterCustomTypeFunc.
 func RegisterCustomValidators() {
 	v := validate
 	if v == nil {
@@ -44,6 +63,22 @@ func RegisterCustomValidators() {
 	if err != nil && !strings.Contains(err.Error(), "already exists") {
 		fmt.Printf("Failed to register strong_password validator: %v\n", err)
 	}
+
+	// Register password validator: validate:"password" or validate:"password=strict|medium|lax"
+	err = v.RegisterValidation("password", validatePasswordPolicyTag)
+	if err != nil && !strings.Contains(err.Error(), "already exists") {
+		fmt.Printf("Failed to register password validator: %v\n", err)
+	}
+
+	// Register the extra format validators (isbn, uuid, latitude, creditcard, ...).
+	registerFormatValidators(v)
+
+	// Register the dotted-path-aware cross-field validators (eqfield, nefield,
+	// gtfield, ltfield, required_if, required_unless, required_with).
+	registerCrossFieldValidators(v)
+
+	// Teach the validator to look through database/sql's Null* wrapper types.
+	registerSQLNullTypes(v)
 }
 
 // ValidateStruct validates a struct using go-playground/validator/v10.
@@ -139,33 +174,107 @@ func convertValidatorErrors(err error) []ValidationError {
 	for _, fieldErr := range validationErrs {
 		message := formatValidationMessage(fieldErr)
 		validationErrors = append(validationErrors, ValidationError{
-			Field:   fieldErr.Field(),
+			Field:   relativeFieldPath(fieldErr),
+			Message: message,
+			Tag:     fieldErr.Tag(),
+			Param:   fieldErr.Param(),
+		})
+	}
+
+	return validationErrors
+}
+
+// relativeFieldPath returns fieldErr's field path relative to the struct
+// passed to Validate*, e.g. "Email", "Emails[2]", or "Contacts[home].Email".
+// Namespace() always begins with the root struct's type name (e.g.
+// "User.Emails[2]"); this strips that leading segment off. For errors with
+// no struct context (ValidateVar), Namespace() is empty and Field() already
+// holds any dive-produced index path.
+func relativeFieldPath(fieldErr validator.FieldError) string {
+	ns := fieldErr.Namespace()
+	if i := strings.Index(ns, "."); i != -1 {
+		return ns[i+1:]
+	}
+	return fieldErr.Field()
+}
+
+// validateStructWithTranslator validates s like ValidateStruct, but formats
+// messages through t instead of the currently installed translator. A
+// message registered via RegisterAliasMessage still takes priority over t.
+func validateStructWithTranslator(s interface{}, t Translator) []ValidationError {
+	v := getValidator()
+
+	err := v.Struct(s)
+	if err == nil {
+		return []ValidationError{}
+	}
+
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []ValidationError{{Field: "unknown", Message: err.Error()}}
+	}
+
+	var validationErrors []ValidationError
+	for _, fieldErr := range validationErrs {
+		var message string
+		if msg, ok := aliasMessage(fieldErr.Tag()); ok {
+			message = msg
+		} else {
+			message = t.Translate(fieldErr.Tag(), fieldErr.Field(), fieldErr.Param())
+		}
+		validationErrors = append(validationErrors, ValidationError{
+			Field:   relativeFieldPath(fieldErr),
 			Message: message,
+			Tag:     fieldErr.Tag(),
+			Param:   fieldErr.Param(),
 		})
 	}
 
+	if validationErrors == nil {
+		return []ValidationError{}
+	}
 	return validationErrors
 }
 
-// formatValidationMessage formats a validator.FieldError into a user-friendly message.
+// formatValidationMessage formats a validator.FieldError into a user-friendly
+// message, using the translator installed via SetTranslator if any, and
+// falling back to the original hard-coded English strings otherwise.
 func formatValidationMessage(fieldErr validator.FieldError) string {
-	switch fieldErr.Tag() {
+	if msg, ok := aliasMessage(fieldErr.Tag()); ok {
+		return msg
+	}
+
+	if t := getActiveTranslator(); t != nil {
+		return t.Translate(fieldErr.Tag(), fieldErr.Field(), fieldErr.Param())
+	}
+
+	return defaultMessageForTag(fieldErr.Tag(), fieldErr.Param())
+}
+
+// defaultMessageForTag holds the original hard-coded English messages, keyed
+// by tag. It backs both the no-translator default path and EnglishTranslator.
+func defaultMessageForTag(tag, param string) string {
+	switch tag {
 	case "required":
 		return fmt.Sprintf("field is required")
 	case "email":
 		return fmt.Sprintf("invalid email format")
 	case "min":
-		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+		return fmt.Sprintf("must be at least %s", param)
 	case "max":
-		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+		return fmt.Sprintf("must be at most %s", param)
 	case "len":
-		return fmt.Sprintf("must be exactly %s characters long", fieldErr.Param())
+		return fmt.Sprintf("must be exactly %s characters long", param)
 	case "village_id":
 		return fmt.Sprintf("invalid Village ID format (expected: TTTT-OOOO-IIIIIIII)")
 	case "strong_password":
 		return fmt.Sprintf("password must be at least 8 characters with uppercase, lowercase, digit, and special character")
+	case "password":
+		return fmt.Sprintf("password does not meet the required strength policy")
 	case "url":
 		return fmt.Sprintf("invalid URL format")
+	case "uri":
+		return fmt.Sprintf("invalid URI format")
 	case "ipv4":
 		return fmt.Sprintf("invalid IPv4 address")
 	case "ipv6":
@@ -174,31 +283,89 @@ func formatValidationMessage(fieldErr validator.FieldError) string {
 		return fmt.Sprintf("invalid IP address")
 	case "alpha":
 		return fmt.Sprintf("must contain only alphabetic characters")
-	case "alphaNum":
+	case "alphanum":
 		return fmt.Sprintf("must contain only alphanumeric characters")
+	case "alphaunicode":
+		return fmt.Sprintf("must contain only unicode letters")
 	case "numeric":
 		return fmt.Sprintf("must be numeric")
 	case "oneof":
-		return fmt.Sprintf("must be one of: %s", fieldErr.Param())
+		return fmt.Sprintf("must be one of: %s", param)
 	case "startswith":
-		return fmt.Sprintf("must start with %s", fieldErr.Param())
+		return fmt.Sprintf("must start with %s", param)
 	case "endswith":
-		return fmt.Sprintf("must end with %s", fieldErr.Param())
+		return fmt.Sprintf("must end with %s", param)
 	case "contains":
-		return fmt.Sprintf("must contain %s", fieldErr.Param())
+		return fmt.Sprintf("must contain %s", param)
 	case "eqfield":
-		return fmt.Sprintf("must equal field %s", fieldErr.Param())
+		return fmt.Sprintf("must equal field %s", param)
 	case "nefield":
-		return fmt.Sprintf("must not equal field %s", fieldErr.Param())
+		return fmt.Sprintf("must not equal field %s", param)
 	case "gtfield":
-		return fmt.Sprintf("must be greater than field %s", fieldErr.Param())
+		return fmt.Sprintf("must be greater than field %s", param)
 	case "gtefield":
-		return fmt.Sprintf("must be greater than or equal to field %s", fieldErr.Param())
+		return fmt.Sprintf("must be greater than or equal to field %s", param)
 	case "ltfield":
-		return fmt.Sprintf("must be less than field %s", fieldErr.Param())
+		return fmt.Sprintf("must be less than field %s", param)
 	case "ltefield":
-		return fmt.Sprintf("must be less than or equal to field %s", fieldErr.Param())
+		return fmt.Sprintf("must be less than or equal to field %s", param)
+	case "required_if":
+		return fmt.Sprintf("field is required when %s", param)
+	case "required_unless":
+		return fmt.Sprintf("field is required unless %s", param)
+	case "required_with":
+		return fmt.Sprintf("field is required when %s is present", param)
+	case "isbn", "isbn10", "isbn13":
+		return fmt.Sprintf("invalid %s", tag)
+	case "uuid", "uuid3", "uuid4", "uuid5":
+		return fmt.Sprintf("invalid %s format", tag)
+	case "ascii":
+		return fmt.Sprintf("must contain only ASCII characters")
+	case "printascii":
+		return fmt.Sprintf("must contain only printable ASCII characters")
+	case "datauri":
+		return fmt.Sprintf("invalid data URI format")
+	case "latitude":
+		return fmt.Sprintf("must be a valid latitude between -90 and 90")
+	case "longitude":
+		return fmt.Sprintf("must be a valid longitude between -180 and 180")
+	case "ssn":
+		return fmt.Sprintf("invalid SSN format (expected: XXX-XX-XXXX)")
+	case "semver":
+		return fmt.Sprintf("must be a valid semantic version")
+	case "creditcard":
+		return fmt.Sprintf("invalid credit card number")
+	case "mac":
+		return fmt.Sprintf("invalid MAC address")
+	case "cidr":
+		return fmt.Sprintf("invalid CIDR notation")
+	case "e164":
+		return fmt.Sprintf("must be a valid E.164 phone number")
+	case "hexcolor":
+		return fmt.Sprintf("invalid hex color")
+	case "rgb":
+		return fmt.Sprintf("invalid RGB color")
+	case "rgba":
+		return fmt.Sprintf("invalid RGBA color")
+	case "hsl":
+		return fmt.Sprintf("invalid HSL color")
+	case "hsla":
+		return fmt.Sprintf("invalid HSLA color")
+	case "hostname":
+		return fmt.Sprintf("invalid hostname")
+	case "hostname_rfc1123":
+		return fmt.Sprintf("invalid hostname (RFC 1123)")
+	case "base64":
+		return fmt.Sprintf("invalid base64 encoding")
+	case "hexadecimal":
+		return fmt.Sprintf("invalid hexadecimal value")
+	case "datetime":
+		return fmt.Sprintf("must be a valid date/time matching the format %s", param)
+	case "iso3166_1_alpha2":
+		return fmt.Sprintf("invalid ISO 3166-1 alpha-2 country code")
+	case "multibyte":
+		return fmt.Sprintf("must contain at least one multi-byte character")
 	default:
-		return fmt.Sprintf("failed validation: %s", fieldErr.Tag())
+		return fmt.Sprintf("failed validation: %s", tag)
 	}
 }
@@ -0,0 +1,22 @@
+package validation
+
+import "testing"
+
+// benchAccount mirrors the Account struct used in TestCombinedValidation.
+type benchAccount struct {
+	VillageID string `validate:"required,village_id"`
+	Password  string `validate:"required,strong_password"`
+}
+
+// BenchmarkValidateStruct benchmarks struct-level validation on a
+// representative struct with custom validator.Func tags. go-playground's
+// validator already parses and caches each struct type's tags internally
+// keyed by reflect.Type, so this package doesn't maintain a second,
+// parallel plan cache on top of it.
+func BenchmarkValidateStruct(b *testing.B) {
+	account := benchAccount{VillageID: "a1b2-c3d4-e5f67890", Password: "MyP@ssw0rd"}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ValidateStruct(account)
+	}
+}
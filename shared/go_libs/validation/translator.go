@@ -0,0 +1,170 @@
+package validation
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+var (
+	localesOnce sync.Once
+	localesMu   sync.RWMutex
+	locales     map[string]map[string]string
+)
+
+// loadLocales parses every embedded locales/*.json bundle into
+// locale -> tag -> template, keyed by filename without extension (e.g. "en").
+// The result is also mutable at runtime via RegisterTranslation.
+func loadLocales() map[string]map[string]string {
+	localesOnce.Do(func() {
+		localesMu.Lock()
+		defer localesMu.Unlock()
+		locales = make(map[string]map[string]string)
+		entries, err := localeFS.ReadDir("locales")
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			name := strings.TrimSuffix(entry.Name(), ".json")
+			data, err := localeFS.ReadFile("locales/" + entry.Name())
+			if err != nil {
+				continue
+			}
+			var bundle map[string]string
+			if err := json.Unmarshal(data, &bundle); err != nil {
+				continue
+			}
+			locales[name] = bundle
+		}
+	})
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	return locales
+}
+
+// RegisterTranslation adds or overrides a single tag's template for locale,
+// e.g. RegisterTranslation("fr", "required", "le champ est requis"). It works
+// for any locale, including ones not shipped in locales/ (a new locale starts
+// from an empty bundle). Templates may reference "{field}" and "{param}".
+func RegisterTranslation(locale, tag, template string) {
+	loadLocales() // ensure the embedded bundles are loaded first
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	if locales[locale] == nil {
+		locales[locale] = make(map[string]string)
+	}
+	locales[locale][tag] = template
+}
+
+// Translator produces a user-facing message for a failed validation tag.
+type Translator interface {
+	Translate(tag, field, param string) string
+}
+
+// EnglishTranslator reproduces formatValidationMessage's original English
+// strings. It's the default behavior when no translator has been set.
+type EnglishTranslator struct{}
+
+// Translate implements Translator using the same switch formatValidationMessage
+// always used.
+func (EnglishTranslator) Translate(tag, field, param string) string {
+	return defaultMessageForTag(tag, param)
+}
+
+// MapTranslator translates using a flat tag -> template map, where templates
+// may reference "{field}" and "{param}" placeholders. Tags missing from
+// Templates fall back to the built-in English message.
+type MapTranslator struct {
+	Templates map[string]string
+}
+
+// Translate implements Translator.
+func (m MapTranslator) Translate(tag, field, param string) string {
+	tmpl, ok := m.Templates[tag]
+	if !ok {
+		return defaultMessageForTag(tag, param)
+	}
+	out := strings.ReplaceAll(tmpl, "{field}", field)
+	out = strings.ReplaceAll(out, "{param}", param)
+	return out
+}
+
+var (
+	translatorMu sync.RWMutex
+	translator   Translator
+)
+
+// SetTranslator installs t as the translator used by ValidateStruct,
+// ValidateStructPartial, and ValidateVar. Pass nil to restore the original
+// hard-coded English behavior.
+func SetTranslator(t Translator) {
+	translatorMu.Lock()
+	translator = t
+	translatorMu.Unlock()
+}
+
+// getActiveTranslator returns the currently installed translator, or nil if
+// none has been set (meaning: use the original hard-coded messages).
+func getActiveTranslator() Translator {
+	translatorMu.RLock()
+	defer translatorMu.RUnlock()
+	return translator
+}
+
+// localeBundle returns a snapshot copy of locale's tag->template map,
+// falling back to "en" if locale is unknown. Safe for concurrent use
+// alongside RegisterTranslation.
+func localeBundle(locale string) map[string]string {
+	loadLocales()
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	bundle, ok := locales[locale]
+	if !ok {
+		bundle = locales["en"]
+	}
+
+	snapshot := make(map[string]string, len(bundle))
+	for k, v := range bundle {
+		snapshot[k] = v
+	}
+	return snapshot
+}
+
+// ValidateStructLocalized validates s and formats errors using the locale
+// bundle for locale (e.g. "en", "es", "de", "fr"), including any templates
+// added via RegisterTranslation. Unknown locales fall back to "en"; tags
+// missing from the bundle fall back to the built-in English message.
+func ValidateStructLocalized(s interface{}, locale string) []ValidationError {
+	return validateStructWithTranslator(s, MapTranslator{Templates: localeBundle(locale)})
+}
+
+// localeContextKey is an unexported type so WithLocale/ValidateStructCtx
+// don't collide with context keys set by other packages.
+type localeContextKey struct{}
+
+// WithLocale returns a copy of ctx carrying locale, for use with ValidateStructCtx.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// localeFromContext returns the locale set via WithLocale, defaulting to "en".
+func localeFromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeContextKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return "en"
+}
+
+// ValidateStructCtx validates s like ValidateStructLocalized, pulling the
+// locale from ctx (set via WithLocale, defaulting to "en").
+func ValidateStructCtx(ctx context.Context, s interface{}) []ValidationError {
+	return ValidateStructLocalized(s, localeFromContext(ctx))
+}
@@ -0,0 +1,133 @@
+package validation
+
+import "testing"
+
+type translatorTestUser struct {
+	Email string `validate:"required,email"`
+}
+
+// TestSetTranslatorEnglish verifies EnglishTranslator reproduces the
+// original hard-coded messages.
+func TestSetTranslatorEnglish(t *testing.T) {
+	SetTranslator(EnglishTranslator{})
+	defer SetTranslator(nil)
+
+	errs := ValidateStruct(translatorTestUser{Email: "invalid"})
+	if len(errs) != 1 || errs[0].Message != "invalid email format" {
+		t.Errorf("expected EnglishTranslator to reproduce the default message, got %v", errs)
+	}
+}
+
+// TestSetTranslatorMapTranslator verifies a custom MapTranslator overrides messages.
+func TestSetTranslatorMapTranslator(t *testing.T) {
+	SetTranslator(MapTranslator{Templates: map[string]string{
+		"email": "{field} must be a valid email address",
+	}})
+	defer SetTranslator(nil)
+
+	errs := ValidateStruct(translatorTestUser{Email: "invalid"})
+	if len(errs) != 1 || errs[0].Message != "Email must be a valid email address" {
+		t.Errorf("expected translated message, got %v", errs)
+	}
+}
+
+// TestSetTranslatorNilRestoresDefault verifies clearing the translator
+// restores the hard-coded English messages.
+func TestSetTranslatorNilRestoresDefault(t *testing.T) {
+	SetTranslator(MapTranslator{Templates: map[string]string{"email": "nope"}})
+	SetTranslator(nil)
+
+	errs := ValidateStruct(translatorTestUser{Email: "invalid"})
+	if len(errs) != 1 || errs[0].Message != "invalid email format" {
+		t.Errorf("expected default message after clearing translator, got %v", errs)
+	}
+}
+
+// TestMapTranslatorFallback verifies a tag missing from Templates falls back
+// to the built-in English message.
+func TestMapTranslatorFallback(t *testing.T) {
+	mt := MapTranslator{Templates: map[string]string{}}
+	if got := mt.Translate("required", "Name", ""); got != "field is required" {
+		t.Errorf("expected fallback message, got %q", got)
+	}
+}
+
+// TestValidateStructLocalized exercises the embedded en/es/de/fr bundles and
+// the fallback for an unknown locale.
+func TestValidateStructLocalized(t *testing.T) {
+	type Account struct {
+		Email string `validate:"required,email"`
+	}
+
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"en", "field is required"},
+		{"es", "el campo es obligatorio"},
+		{"de", "Feld ist erforderlich"},
+		{"fr", "le champ est requis"},
+		{"it", "field is required"}, // unknown locale falls back to en
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			errs := ValidateStructLocalized(Account{}, tt.locale)
+			if len(errs) == 0 {
+				t.Fatalf("expected errors for empty Account")
+			}
+			var msg string
+			for _, e := range errs {
+				if e.Field == "Email" {
+					msg = e.Message
+				}
+			}
+			if msg != tt.want {
+				t.Errorf("locale %s: expected %q, got %q", tt.locale, tt.want, msg)
+			}
+		})
+	}
+}
+
+// TestLocaleBundlesHaveMatchingTags verifies the Spanish, German, and French
+// bundles have a template for every tag in the English bundle, and no tag
+// the English bundle lacks.
+func TestLocaleBundlesHaveMatchingTags(t *testing.T) {
+	bundles := loadLocales()
+	en, ok := bundles["en"]
+	if !ok {
+		t.Fatal("expected an 'en' locale bundle to be embedded")
+	}
+
+	for _, locale := range []string{"es", "de", "fr"} {
+		bundle, ok := bundles[locale]
+		if !ok {
+			t.Fatalf("expected a %q locale bundle to be embedded", locale)
+		}
+		for tag := range en {
+			if _, ok := bundle[tag]; !ok {
+				t.Errorf("locale %q is missing a template for tag %q", locale, tag)
+			}
+		}
+		for tag := range bundle {
+			if _, ok := en[tag]; !ok {
+				t.Errorf("locale %q has an extra tag %q not present in en", locale, tag)
+			}
+		}
+	}
+}
+
+// TestValidationErrorTagParam verifies ValidationError now carries the
+// failing tag and its parameter.
+func TestValidationErrorTagParam(t *testing.T) {
+	type Data struct {
+		Name string `validate:"min=5"`
+	}
+	errs := ValidateStruct(Data{Name: "ab"})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errs))
+	}
+	if errs[0].Tag != "min" || errs[0].Param != "5" {
+		t.Errorf("expected Tag=min Param=5, got Tag=%q Param=%q", errs[0].Tag, errs[0].Param)
+	}
+}